@@ -0,0 +1,64 @@
+package flags
+
+import "github.com/urfave/cli/v2"
+
+// Flags used by the prover/proof_submitter package to tune proveBlock transaction submission.
+var (
+	ProverMaxGasTipCap = &cli.Uint64Flag{
+		Name:    "prover.maxGasTipCap",
+		Usage:   "Maximum gasTipCap (in wei) the prover is willing to pay for a proveBlock transaction, leave unset for no cap",
+		EnvVars: []string{"PROVER_MAX_GAS_TIP_CAP"},
+	}
+	ProverMaxGasFeeCap = &cli.Uint64Flag{
+		Name:    "prover.maxGasFeeCap",
+		Usage:   "Maximum gasFeeCap (in wei) the prover is willing to pay for a proveBlock transaction, leave unset for no cap",
+		EnvVars: []string{"PROVER_MAX_GAS_FEE_CAP"},
+	}
+	ProverConditionalTxs = &cli.StringFlag{
+		Name:    "prover.conditionalTxs",
+		Usage:   "Whether to submit proveBlock transactions via eth_sendRawTransactionConditional: auto|on|off",
+		Value:   "auto",
+		EnvVars: []string{"PROVER_CONDITIONAL_TXS"},
+	}
+	ProverTaikoL1ProposedBlocksSlot = &cli.Uint64Flag{
+		Name: "prover.taikoL1ProposedBlocksSlot",
+		Usage: "Declared storage slot of the TaikoL1 contract's `proposedBlocks` mapping itself (the slot " +
+			"`forge inspect TaikoL1 storage-layout` reports for that variable, NOT the slot metaHash ends up " +
+			"at -- see --prover.taikoL1ProposedBlocksMetaHashOffset for that); " +
+			"leave unset to disable the knownAccounts reorg precondition on conditional proveBlock transactions",
+		EnvVars: []string{"PROVER_TAIKO_L1_PROPOSED_BLOCKS_SLOT"},
+	}
+	ProverTaikoL1ProposedBlocksMetaHashOffset = &cli.Uint64Flag{
+		Name: "prover.taikoL1ProposedBlocksMetaHashOffset",
+		Usage: "Number of full storage slots occupied by the TaikoData.Block fields declared before metaHash, " +
+			"as verified against the deployed contract's source (forge inspect's mapping slot alone does not " +
+			"account for this); defaults to 0, i.e. metaHash is the struct's first field -- verify this against " +
+			"the actual deployed contract rather than assuming it, a wrong value silently breaks the " +
+			"--prover.taikoL1ProposedBlocksSlot reorg precondition",
+		EnvVars: []string{"PROVER_TAIKO_L1_PROPOSED_BLOCKS_META_HASH_OFFSET"},
+	}
+	ProverDelayStrategy = &cli.StringFlag{
+		Name:    "prover.delayStrategy",
+		Usage:   "Strategy used to decide how long to wait after a block was proposed before submitting its proof: immediate|proportional|pid",
+		Value:   "proportional",
+		EnvVars: []string{"PROVER_DELAY_STRATEGY"},
+	}
+	ProverDelayStrategyPIDKp = &cli.Float64Flag{
+		Name:    "prover.delayStrategy.pid.kp",
+		Usage:   "Proportional gain used by the \"pid\" --prover.delayStrategy",
+		Value:   1,
+		EnvVars: []string{"PROVER_DELAY_STRATEGY_PID_KP"},
+	}
+	ProverDelayStrategyPIDKi = &cli.Float64Flag{
+		Name:    "prover.delayStrategy.pid.ki",
+		Usage:   "Integral gain used by the \"pid\" --prover.delayStrategy",
+		Value:   0.5,
+		EnvVars: []string{"PROVER_DELAY_STRATEGY_PID_KI"},
+	}
+	ProverDelayStrategyPIDWindow = &cli.IntFlag{
+		Name:    "prover.delayStrategy.pid.window",
+		Usage:   "Number of trailing accepted proofs averaged into the \"pid\" --prover.delayStrategy's integral term",
+		Value:   10,
+		EnvVars: []string{"PROVER_DELAY_STRATEGY_PID_WINDOW"},
+	}
+)
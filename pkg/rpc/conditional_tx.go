@@ -0,0 +1,106 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// TransactionConditionalOptions mirrors the `options` argument accepted by the
+// `eth_sendRawTransactionConditional` JSON-RPC method (the EIP-4337 / bor-style conditional
+// transaction extension): the node will only include the transaction in a block if every
+// declared precondition still holds at inclusion time, instead of broadcasting it unconditionally
+// and letting a stale L1 context revert on-chain.
+type TransactionConditionalOptions struct {
+	// KnownAccounts maps an address to either its expected storage root, or a map of expected
+	// storage slot values, both of which must still match at inclusion time.
+	KnownAccounts  map[common.Address]KnownAccountState  `json:"knownAccounts,omitempty"`
+	BlockNumberMin *big.Int                              `json:"blockNumberMin,omitempty"`
+	BlockNumberMax *big.Int                              `json:"blockNumberMax,omitempty"`
+	TimestampMin   *uint64                               `json:"timestampMin,omitempty"`
+	TimestampMax   *uint64                               `json:"timestampMax,omitempty"`
+}
+
+// KnownAccountState represents either an expected storage root (common.Hash) or a set of
+// expected storage slot values (map[common.Hash]common.Hash) for a `knownAccounts` entry.
+// Exactly one of the two fields should be set.
+type KnownAccountState struct {
+	StorageRoot  *common.Hash
+	StorageSlots map[common.Hash]common.Hash
+}
+
+// MarshalJSON implements the json.Marshaler interface, encoding a KnownAccountState as either
+// a single hash or a slot -> value map, matching the shape the node expects.
+func (s KnownAccountState) MarshalJSON() ([]byte, error) {
+	if s.StorageSlots != nil {
+		return json.Marshal(s.StorageSlots)
+	}
+	return json.Marshal(s.StorageRoot)
+}
+
+// SendRawTransactionConditionalMethodName is the JSON-RPC method name probed on startup and
+// used to submit conditional transactions.
+const SendRawTransactionConditionalMethodName = "eth_sendRawTransactionConditional"
+
+// SendRawTransactionConditional submits the given signed transaction along with a set of
+// L1-state preconditions via `eth_sendRawTransactionConditional`. The node only includes the
+// transaction in a block if every precondition in opts still holds at inclusion time, which
+// closes the TOCTOU window between broadcasting and mining a proveBlock transaction.
+func (c *Client) SendRawTransactionConditional(
+	ctx context.Context,
+	tx *types.Transaction,
+	opts *TransactionConditionalOptions,
+) error {
+	data, err := tx.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	return c.L1RawRPC.CallContext(
+		ctx,
+		nil,
+		SendRawTransactionConditionalMethodName,
+		hexutil.Encode(data),
+		opts,
+	)
+}
+
+// SupportsSendRawTransactionConditional probes whether the connected L1 node advertises the
+// `eth_sendRawTransactionConditional` method, so the submitter can fall back to a plain
+// `eth_sendRawTransaction` when it is not available.
+func (c *Client) SupportsSendRawTransactionConditional(ctx context.Context) bool {
+	var result interface{}
+	// A node that doesn't know the method returns a "method not found" JSON-RPC error, any other
+	// response (including an invalid-params error for this parameterless probe) means it's there.
+	err := c.L1RawRPC.CallContext(ctx, &result, SendRawTransactionConditionalMethodName)
+
+	return err == nil || !isMethodNotFoundErr(err)
+}
+
+// methodNotFoundErrCode is the standard JSON-RPC error code for an unknown method (see
+// https://www.jsonrpc.org/specification#error_object), which is what a node that doesn't
+// implement eth_sendRawTransactionConditional returns. Checking the code rather than the error
+// message is required since clients word the message differently, e.g. go-ethereum's
+// "the method ... does not exist/is not available" doesn't contain "method not found".
+const methodNotFoundErrCode = -32601
+
+// isMethodNotFoundErr reports whether err is a JSON-RPC "method not found" error, which
+// indicates the connected node doesn't support the probed method.
+func isMethodNotFoundErr(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var rpcErr gethrpc.Error
+	if errors.As(err, &rpcErr) {
+		return rpcErr.ErrorCode() == methodNotFoundErrCode
+	}
+
+	return false
+}
@@ -0,0 +1,44 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// TxPoolContentMethodName and TxPoolContentFromMethodName are the JSON-RPC methods used to
+// inspect the connected node's mempool.
+const (
+	TxPoolContentMethodName     = "txpool_content"
+	TxPoolContentFromMethodName = "txpool_contentFrom"
+)
+
+// TxPoolContent is the decoded result of `txpool_content` / `txpool_contentFrom`: pending and
+// queued transactions, keyed first by sender address then by nonce.
+type TxPoolContent struct {
+	Pending map[common.Address]map[uint64]*types.Transaction `json:"pending"`
+	Queued  map[common.Address]map[uint64]*types.Transaction `json:"queued"`
+}
+
+// Content returns the full content of the connected node's transaction pool, mirroring the
+// `txpool_content` JSON-RPC method.
+func (c *Client) Content(ctx context.Context) (*TxPoolContent, error) {
+	var result TxPoolContent
+	if err := c.L1RawRPC.CallContext(ctx, &result, TxPoolContentMethodName); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ContentFrom returns the pending and queued transactions of the given address in the
+// connected node's transaction pool, mirroring the `txpool_contentFrom` JSON-RPC method.
+func (c *Client) ContentFrom(ctx context.Context, address common.Address) (*TxPoolContent, error) {
+	var result TxPoolContent
+	if err := c.L1RawRPC.CallContext(ctx, &result, TxPoolContentFromMethodName, address); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,162 @@
+package submitter
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// ConditionalTxMode controls whether the submitter broadcasts proveBlock transactions through
+// `eth_sendRawTransactionConditional` instead of a plain send, see --prover.conditionalTxs.
+type ConditionalTxMode string
+
+const (
+	ConditionalTxModeAuto ConditionalTxMode = "auto"
+	ConditionalTxModeOn   ConditionalTxMode = "on"
+	ConditionalTxModeOff  ConditionalTxMode = "off"
+)
+
+// resolveConditionalTxMode decides whether conditional transactions should be used, performing
+// a capability probe against the connected L1 node when mode is "auto".
+func resolveConditionalTxMode(ctx context.Context, cli *rpc.Client, mode ConditionalTxMode) bool {
+	switch mode {
+	case ConditionalTxModeOn:
+		return true
+	case ConditionalTxModeOff:
+		return false
+	default:
+		supported := cli.SupportsSendRawTransactionConditional(ctx)
+		log.Info("Probed eth_sendRawTransactionConditional support", "supported", supported)
+		return supported
+	}
+}
+
+// blockMetaHashStorageSlot returns the storage slot holding `proposedBlocks[blockID].metaHash`,
+// given the mapping's own base slot within the TaikoL1 contract, so that a conditional
+// transaction's knownAccounts precondition drops the tx instead of reverting it on-chain
+// whenever a reorg changes that block's metadata. proposedBlocksBaseSlot MUST be taken from the
+// deployed contract's verified storage layout (e.g. `forge inspect TaikoL1 storage-layout`) --
+// guessing it would silently turn this precondition into either a no-op (always matches, no
+// protection) or a permanent rejection (never matches, proveBlock txs never land).
+//
+// keccak256(blockID . proposedBlocksBaseSlot) only yields the *first* storage slot occupied by
+// `proposedBlocks[blockID]`; metaHash lives at that slot plus metaHashFieldOffset, the number of
+// full slots taken up by the TaikoData.Block fields declared before it. metaHashFieldOffset must
+// likewise come from the deployed contract's verified struct layout, not be assumed to be 0.
+func blockMetaHashStorageSlot(blockID *big.Int, proposedBlocksBaseSlot *big.Int, metaHashFieldOffset *big.Int) common.Hash {
+	valueSlot := crypto.Keccak256Hash(
+		common.LeftPadBytes(blockID.Bytes(), 32),
+		common.LeftPadBytes(proposedBlocksBaseSlot.Bytes(), 32),
+	)
+
+	return common.BigToHash(new(big.Int).Add(valueSlot.Big(), metaHashFieldOffset))
+}
+
+// buildConditionalTxOpts builds the L1-state preconditions for submitting the proveBlock
+// transaction of the given block: the transaction must be included within proofCooldownWindow
+// L1 blocks of when it was proposed, and, when proposedBlocksBaseSlot is configured (verified
+// against the deployed TaikoL1 contract's storage layout), the on-chain metadata hash stored
+// for blockID must still match meta.L1Hash -- the same canonical-chain check sendTxWithBackoff
+// already performs before broadcasting. proposedBlocksBaseSlot may be nil, in which case the
+// storage-slot precondition is omitted rather than guessed; metaHashFieldOffset is ignored in
+// that case.
+func buildConditionalTxOpts(
+	taikoL1Address common.Address,
+	blockID *big.Int,
+	meta *bindings.TaikoDataBlockMetadata,
+	proposedBlocksBaseSlot *big.Int,
+	metaHashFieldOffset *big.Int,
+	proofCooldownWindow uint64,
+) *rpc.TransactionConditionalOptions {
+	opts := &rpc.TransactionConditionalOptions{
+		BlockNumberMax: new(big.Int).SetUint64(meta.L1Height + proofCooldownWindow),
+	}
+
+	if proposedBlocksBaseSlot != nil {
+		opts.KnownAccounts = map[common.Address]rpc.KnownAccountState{
+			taikoL1Address: {
+				StorageSlots: map[common.Hash]common.Hash{
+					blockMetaHashStorageSlot(blockID, proposedBlocksBaseSlot, metaHashFieldOffset): common.BytesToHash(meta.L1Hash[:]),
+				},
+			},
+		}
+	}
+
+	return opts
+}
+
+// ConditionalTxResolver probes whether the connected L1 node supports
+// eth_sendRawTransactionConditional once on startup and caches the result, rather than
+// re-probing on every proveBlock submission.
+type ConditionalTxResolver struct {
+	taikoL1Address         common.Address
+	proposedBlocksBaseSlot *big.Int
+	metaHashFieldOffset    *big.Int
+	proofCooldownWindow    uint64
+
+	supported bool
+}
+
+// NewConditionalTxResolver performs the startup capability probe (skipped when mode is "on" or
+// "off") and returns a resolver ready to build per-block conditional tx options.
+func NewConditionalTxResolver(
+	ctx context.Context,
+	cli *rpc.Client,
+	mode ConditionalTxMode,
+	taikoL1Address common.Address,
+	proposedBlocksBaseSlot *big.Int,
+	metaHashFieldOffset *big.Int,
+	proofCooldownWindow uint64,
+) *ConditionalTxResolver {
+	return &ConditionalTxResolver{
+		taikoL1Address:         taikoL1Address,
+		proposedBlocksBaseSlot: proposedBlocksBaseSlot,
+		metaHashFieldOffset:    metaHashFieldOffset,
+		proofCooldownWindow:    proofCooldownWindow,
+		supported:              resolveConditionalTxMode(ctx, cli, mode),
+	}
+}
+
+// ConditionalTxOpts builds the knownAccounts/blockNumberMax preconditions for the given block,
+// or returns nil when conditional transactions are disabled or unsupported, so callers can pass
+// the result straight through to sendTxWithBackoffOpts.ConditionalTxOpts and fall back to a
+// plain send.
+func (r *ConditionalTxResolver) ConditionalTxOpts(
+	blockID *big.Int,
+	meta *bindings.TaikoDataBlockMetadata,
+) *rpc.TransactionConditionalOptions {
+	if !r.supported {
+		return nil
+	}
+
+	return buildConditionalTxOpts(
+		r.taikoL1Address,
+		blockID,
+		meta,
+		r.proposedBlocksBaseSlot,
+		r.metaHashFieldOffset,
+		r.proofCooldownWindow,
+	)
+}
+
+// broadcastTx sends the given signed transaction, using a conditional transaction when
+// conditionalTxOpts is non-nil and falling back to a plain send otherwise (e.g. when the node
+// doesn't support `eth_sendRawTransactionConditional`).
+func broadcastTx(
+	ctx context.Context,
+	cli *rpc.Client,
+	tx *types.Transaction,
+	conditionalTxOpts *rpc.TransactionConditionalOptions,
+) error {
+	if conditionalTxOpts != nil {
+		return cli.SendRawTransactionConditional(ctx, tx, conditionalTxOpts)
+	}
+
+	return cli.L1.SendTransaction(ctx, tx)
+}
@@ -0,0 +1,56 @@
+package submitter
+
+import (
+	"math/big"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-client/cmd/flags"
+)
+
+// MaxGasCapsFromContext reads the --prover.maxGasTipCap / --prover.maxGasFeeCap flag values (in
+// wei) into the *big.Int caps getProveBlocksTxOpts and sendTxWithBackoff expect, returning nil
+// for either flag that wasn't set so that fee stays uncapped.
+func MaxGasCapsFromContext(c *cli.Context) (maxGasTipCap *big.Int, maxGasFeeCap *big.Int) {
+	if v := c.Uint64(flags.ProverMaxGasTipCap.Name); v != 0 {
+		maxGasTipCap = new(big.Int).SetUint64(v)
+	}
+	if v := c.Uint64(flags.ProverMaxGasFeeCap.Name); v != 0 {
+		maxGasFeeCap = new(big.Int).SetUint64(v)
+	}
+
+	return maxGasTipCap, maxGasFeeCap
+}
+
+// ConditionalTxModeFromContext reads the --prover.conditionalTxs flag into a ConditionalTxMode.
+func ConditionalTxModeFromContext(c *cli.Context) ConditionalTxMode {
+	return ConditionalTxMode(c.String(flags.ProverConditionalTxs.Name))
+}
+
+// ProposedBlocksBaseSlotFromContext reads the --prover.taikoL1ProposedBlocksSlot flag, returning
+// nil when it wasn't set so the knownAccounts reorg precondition is omitted rather than built
+// against a guessed slot.
+func ProposedBlocksBaseSlotFromContext(c *cli.Context) *big.Int {
+	if !c.IsSet(flags.ProverTaikoL1ProposedBlocksSlot.Name) {
+		return nil
+	}
+
+	return new(big.Int).SetUint64(c.Uint64(flags.ProverTaikoL1ProposedBlocksSlot.Name))
+}
+
+// MetaHashFieldOffsetFromContext reads the --prover.taikoL1ProposedBlocksMetaHashOffset flag,
+// defaulting to 0 (metaHash being the struct's first field) when it wasn't set.
+func MetaHashFieldOffsetFromContext(c *cli.Context) *big.Int {
+	return new(big.Int).SetUint64(c.Uint64(flags.ProverTaikoL1ProposedBlocksMetaHashOffset.Name))
+}
+
+// DelayStrategyFromContext builds the ProofDelayStrategy selected by --prover.delayStrategy,
+// passing the --prover.delayStrategy.pid.* flag values through to PIDStrategy.
+func DelayStrategyFromContext(c *cli.Context) (ProofDelayStrategy, error) {
+	return NewProofDelayStrategy(
+		c.String(flags.ProverDelayStrategy.Name),
+		c.Float64(flags.ProverDelayStrategyPIDKp.Name),
+		c.Float64(flags.ProverDelayStrategyPIDKi.Name),
+		c.Int(flags.ProverDelayStrategyPIDWindow.Name),
+	)
+}
@@ -0,0 +1,65 @@
+package submitter
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// proveBlockMethodName is the TaikoL1 contract method both proveBlock and proveBlockInvalid
+// transactions are decoded against.
+const proveBlockMethodName = "proveBlock"
+
+// findPendingProveBlockTx inspects the connected node's mempool for a transaction from
+// proverAddress that is already targeting TaikoL1.proveBlock for the given blockID, so the
+// caller can avoid broadcasting a nonce-colliding duplicate. It returns nil if none is found.
+func findPendingProveBlockTx(
+	ctx context.Context,
+	cli *rpc.Client,
+	proverAddress common.Address,
+	taikoL1Address common.Address,
+	blockID *big.Int,
+) (*types.Transaction, error) {
+	content, err := cli.ContentFrom(ctx, proverAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range content.Pending[proverAddress] {
+		if tx.To() == nil || *tx.To() != taikoL1Address {
+			continue
+		}
+
+		if targetsBlockID(tx, blockID) {
+			return tx, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// targetsBlockID decodes tx's calldata as a TaikoL1.proveBlock call and reports whether its
+// first argument (the blockID) matches the given blockID.
+func targetsBlockID(tx *types.Transaction, blockID *big.Int) bool {
+	data := tx.Data()
+	if len(data) < 4 || !bytes.Equal(data[:4], encoding.TaikoL1ABI.Methods[proveBlockMethodName].ID) {
+		return false
+	}
+
+	args, err := encoding.TaikoL1ABI.Methods[proveBlockMethodName].Inputs.Unpack(data[4:])
+	if err != nil || len(args) == 0 {
+		return false
+	}
+
+	decodedBlockID, ok := args[0].(*big.Int)
+	if !ok {
+		return false
+	}
+
+	return decodedBlockID.Cmp(blockID) == 0
+}
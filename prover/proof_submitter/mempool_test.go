@@ -0,0 +1,50 @@
+package submitter
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+func proveBlockTx(t *testing.T, blockID *big.Int) *types.Transaction {
+	t.Helper()
+
+	data, err := encoding.TaikoL1ABI.Methods[proveBlockMethodName].Inputs.Pack(blockID, []byte{})
+	if err != nil {
+		t.Fatalf("failed to pack proveBlock calldata: %v", err)
+	}
+
+	return types.NewTx(&types.LegacyTx{
+		Data: append(encoding.TaikoL1ABI.Methods[proveBlockMethodName].ID, data...),
+	})
+}
+
+func TestTargetsBlockID(t *testing.T) {
+	blockID := big.NewInt(42)
+	tx := proveBlockTx(t, blockID)
+
+	if !targetsBlockID(tx, blockID) {
+		t.Error("targetsBlockID() = false for the block it targets, want true")
+	}
+	if targetsBlockID(tx, big.NewInt(43)) {
+		t.Error("targetsBlockID() = true for a different blockID, want false")
+	}
+}
+
+func TestTargetsBlockIDRejectsNonProveBlockCalldata(t *testing.T) {
+	tx := types.NewTx(&types.LegacyTx{Data: []byte{0xde, 0xad, 0xbe, 0xef}})
+
+	if targetsBlockID(tx, big.NewInt(42)) {
+		t.Error("targetsBlockID() = true for non-proveBlock calldata, want false")
+	}
+}
+
+func TestTargetsBlockIDRejectsShortCalldata(t *testing.T) {
+	tx := types.NewTx(&types.LegacyTx{Data: []byte{0x01, 0x02}})
+
+	if targetsBlockID(tx, big.NewInt(42)) {
+		t.Error("targetsBlockID() = true for short calldata, want false")
+	}
+}
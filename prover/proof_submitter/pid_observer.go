@@ -0,0 +1,69 @@
+package submitter
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// WatchPIDStrategyProofTimes subscribes to TaikoL1.BlockProven events and feeds each accepted
+// proof's actual proof time (the L1 block it was proven in minus the block's ProposedAt) to
+// strategy.Observe, so its integral term reflects real protocol history instead of staying
+// permanently empty. It blocks until ctx is cancelled or the subscription errors, and is meant to
+// be run in its own goroutine alongside the submitter that owns strategy.
+func WatchPIDStrategyProofTimes(ctx context.Context, cli *rpc.Client, strategy *PIDStrategy) error {
+	sink := make(chan *bindings.TaikoL1BlockProven)
+
+	sub, err := cli.TaikoL1.WatchBlockProven(&bind.WatchOpts{Context: ctx}, sink, nil)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-sub.Err():
+			return err
+		case event := <-sink:
+			observePIDStrategyProofTime(ctx, cli, strategy, event)
+		}
+	}
+}
+
+// observePIDStrategyProofTime looks up the given BlockProven event's proposal time and the
+// protocol's current ProofTimeTarget, and feeds the resulting sample to strategy.Observe,
+// logging and skipping the sample on any lookup failure rather than failing the subscription.
+func observePIDStrategyProofTime(
+	ctx context.Context,
+	cli *rpc.Client,
+	strategy *PIDStrategy,
+	event *bindings.TaikoL1BlockProven,
+) {
+	block, err := cli.TaikoL1.GetBlock(nil, event.Id)
+	if err != nil {
+		log.Warn("Failed to fetch proposed block for PID proof time observation", "blockID", event.Id, "error", err)
+		return
+	}
+
+	provenAt, err := cli.L1.HeaderByNumber(ctx, new(big.Int).SetUint64(event.Raw.BlockNumber))
+	if err != nil {
+		log.Warn("Failed to fetch L1 header for PID proof time observation", "blockID", event.Id, "error", err)
+		return
+	}
+
+	stateVar, err := cli.TaikoL1.GetStateVariables(nil)
+	if err != nil {
+		log.Warn("Failed to get protocol state variables for PID proof time observation", "blockID", event.Id, "error", err)
+		return
+	}
+
+	actualProofTime := time.Duration(provenAt.Time-block.ProposedAt) * time.Second
+	strategy.Observe(actualProofTime, stateVar.ProofTimeTarget)
+}
@@ -0,0 +1,158 @@
+package submitter
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+// Supported --prover.delayStrategy flag values.
+const (
+	DelayStrategyImmediate    = "immediate"
+	DelayStrategyProportional = "proportional"
+	DelayStrategyPID          = "pid"
+)
+
+// ProofDelayStrategy decides how long to wait after a block was proposed before submitting its
+// proof, trading off gas cost (submitting early, when fewer other provers have given up, tends
+// to cost more) against the protocol's ProofTimeTarget. Implementations are pure functions of
+// their inputs (and, for PIDStrategy, of state fed to them separately) so they can be
+// unit-tested without an RPC connection.
+type ProofDelayStrategy interface {
+	// ComputeDelay returns how long after proposedAt the proveBlock transaction should be
+	// submitted for blockID, given the protocol's current state variables.
+	ComputeDelay(
+		ctx context.Context,
+		blockID *big.Int,
+		proposedAt uint64,
+		expectedReward uint64,
+		stateVar bindings.TaikoDataStateVariables,
+	) time.Duration
+}
+
+// ImmediateStrategy always submits the proof immediately, ignoring ProofTimeTarget entirely.
+type ImmediateStrategy struct{}
+
+// ComputeDelay implements the ProofDelayStrategy interface.
+func (s *ImmediateStrategy) ComputeDelay(
+	context.Context,
+	*big.Int,
+	uint64,
+	uint64,
+	bindings.TaikoDataStateVariables,
+) time.Duration {
+	return 0
+}
+
+// ProportionalStrategy reproduces the reward/fee-proportional heuristic that used to be inlined
+// in sendTxWithBackoff: it scales ProofTimeTarget by expectedReward/BlockFee, clamps the result
+// to [ProofTimeTarget/4, ProofTimeTarget*4], and negates it.
+type ProportionalStrategy struct{}
+
+// ComputeDelay implements the ProofDelayStrategy interface.
+func (s *ProportionalStrategy) ComputeDelay(
+	_ context.Context,
+	_ *big.Int,
+	_ uint64,
+	expectedReward uint64,
+	stateVar bindings.TaikoDataStateVariables,
+) time.Duration {
+	targetDelay := stateVar.ProofTimeTarget * 4
+	if stateVar.BlockFee != 0 {
+		targetDelay = uint64(float64(expectedReward) / float64(stateVar.BlockFee) * float64(stateVar.ProofTimeTarget))
+		if targetDelay < stateVar.ProofTimeTarget/4 {
+			targetDelay = stateVar.ProofTimeTarget / 4
+		} else if targetDelay > stateVar.ProofTimeTarget*4 {
+			targetDelay = stateVar.ProofTimeTarget * 4
+		}
+	}
+
+	// Negating the delay effectively submits immediately regardless of the computed value;
+	// kept for behavioral parity with the pre-extraction implementation.
+	return -time.Duration(targetDelay) * time.Second
+}
+
+// PIDStrategy adjusts the delay with a PI controller: a proportional term on the same
+// reward/fee ratio ProportionalStrategy uses, and an integral term on the trailing error
+// between recent accepted proofs' actual proof time and ProofTimeTarget. Observe must be called
+// by the caller (typically from a TaikoL1 BlockProven event subscription) to feed it new
+// samples; ComputeDelay itself stays a pure function of its inputs and the accumulated errors.
+type PIDStrategy struct {
+	KP, KI float64
+	Window int
+
+	errors []float64 // trailing (actual - target) proof time samples in seconds, oldest first
+}
+
+// NewPIDStrategy creates a PIDStrategy with the given gains, averaging the trailing error over
+// the last window accepted proofs.
+func NewPIDStrategy(kp, ki float64, window int) *PIDStrategy {
+	return &PIDStrategy{KP: kp, KI: ki, Window: window}
+}
+
+// Observe records a newly accepted proof's actual proof time against the protocol's
+// ProofTimeTarget at the time it was accepted, trimming the trailing window.
+func (s *PIDStrategy) Observe(actualProofTime time.Duration, proofTimeTarget uint64) {
+	s.errors = append(s.errors, actualProofTime.Seconds()-float64(proofTimeTarget))
+	if len(s.errors) > s.Window {
+		s.errors = s.errors[len(s.errors)-s.Window:]
+	}
+}
+
+// ComputeDelay implements the ProofDelayStrategy interface.
+func (s *PIDStrategy) ComputeDelay(
+	_ context.Context,
+	_ *big.Int,
+	_ uint64,
+	expectedReward uint64,
+	stateVar bindings.TaikoDataStateVariables,
+) time.Duration {
+	proportionalTerm := float64(stateVar.ProofTimeTarget)
+	if stateVar.BlockFee != 0 {
+		proportionalTerm = float64(expectedReward) / float64(stateVar.BlockFee) * float64(stateVar.ProofTimeTarget)
+	}
+
+	var integralTerm float64
+	for _, e := range s.errors {
+		integralTerm += e
+	}
+	if len(s.errors) > 0 {
+		integralTerm /= float64(len(s.errors))
+	}
+
+	// integralTerm is the trailing average of (actual - target) proof times: positive when
+	// recent proofs have landed later than target, negative when they've landed earlier. The
+	// correction must push the delay in the opposite direction of that error (submit sooner
+	// after a run of late proofs, later after a run of early ones) for the loop to converge, so
+	// it's subtracted rather than added.
+	delaySeconds := s.KP*proportionalTerm - s.KI*integralTerm
+
+	min := float64(stateVar.ProofTimeTarget) / 4
+	max := float64(stateVar.ProofTimeTarget) * 4
+	if delaySeconds < min {
+		delaySeconds = min
+	} else if delaySeconds > max {
+		delaySeconds = max
+	}
+
+	return time.Duration(delaySeconds) * time.Second
+}
+
+// NewProofDelayStrategy builds the ProofDelayStrategy selected by the --prover.delayStrategy
+// flag, passing the --prover.delayStrategy.pid.kp / .ki / .window flag values through to
+// PIDStrategy. See DelayStrategyFromContext.
+func NewProofDelayStrategy(name string, kp, ki float64, window int) (ProofDelayStrategy, error) {
+	switch name {
+	case DelayStrategyImmediate:
+		return &ImmediateStrategy{}, nil
+	case DelayStrategyProportional:
+		return &ProportionalStrategy{}, nil
+	case DelayStrategyPID:
+		return NewPIDStrategy(kp, ki, window), nil
+	default:
+		return nil, fmt.Errorf("unknown proof delay strategy: %s", name)
+	}
+}
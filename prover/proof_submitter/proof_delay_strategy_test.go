@@ -0,0 +1,139 @@
+package submitter
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+func TestImmediateStrategyComputeDelay(t *testing.T) {
+	s := &ImmediateStrategy{}
+
+	delay := s.ComputeDelay(context.Background(), big.NewInt(1), 0, 100, bindings.TaikoDataStateVariables{
+		BlockFee:        10,
+		ProofTimeTarget: 60,
+	})
+
+	if delay != 0 {
+		t.Errorf("ComputeDelay() = %s, want 0", delay)
+	}
+}
+
+func TestProportionalStrategyComputeDelay(t *testing.T) {
+	tests := []struct {
+		name           string
+		expectedReward uint64
+		stateVar       bindings.TaikoDataStateVariables
+		want           time.Duration
+	}{
+		{
+			name:           "zero block fee falls back to 4x target",
+			expectedReward: 100,
+			stateVar:       bindings.TaikoDataStateVariables{BlockFee: 0, ProofTimeTarget: 60},
+			want:           -4 * 60 * time.Second,
+		},
+		{
+			name:           "clamped to the lower bound",
+			expectedReward: 1,
+			stateVar:       bindings.TaikoDataStateVariables{BlockFee: 1000, ProofTimeTarget: 60},
+			want:           -(60 / 4) * time.Second,
+		},
+		{
+			name:           "clamped to the upper bound",
+			expectedReward: 1000,
+			stateVar:       bindings.TaikoDataStateVariables{BlockFee: 1, ProofTimeTarget: 60},
+			want:           -4 * 60 * time.Second,
+		},
+		{
+			name:           "proportional to the reward / fee ratio",
+			expectedReward: 50,
+			stateVar:       bindings.TaikoDataStateVariables{BlockFee: 100, ProofTimeTarget: 60},
+			want:           -30 * time.Second,
+		},
+	}
+
+	s := &ProportionalStrategy{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := s.ComputeDelay(context.Background(), big.NewInt(1), 0, tt.expectedReward, tt.stateVar)
+			if got != tt.want {
+				t.Errorf("ComputeDelay() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPIDStrategyObserveTrimsWindow(t *testing.T) {
+	s := NewPIDStrategy(1, 1, 2)
+
+	s.Observe(10*time.Second, 60)
+	s.Observe(20*time.Second, 60)
+	s.Observe(30*time.Second, 60)
+
+	if len(s.errors) != 2 {
+		t.Fatalf("len(errors) = %d, want 2", len(s.errors))
+	}
+	if s.errors[0] != -40 || s.errors[1] != -30 {
+		t.Errorf("errors = %v, want [-40 -30]", s.errors)
+	}
+}
+
+func TestPIDStrategyComputeDelay(t *testing.T) {
+	s := NewPIDStrategy(1, 1, 10)
+	stateVar := bindings.TaikoDataStateVariables{BlockFee: 100, ProofTimeTarget: 60}
+
+	// With no observations yet, the integral term is 0 and the delay is purely proportional.
+	got := s.ComputeDelay(context.Background(), big.NewInt(1), 0, 50, stateVar)
+	if want := 30 * time.Second; got != want {
+		t.Errorf("ComputeDelay() with no observations = %s, want %s", got, want)
+	}
+
+	// A persistent positive error (proofs landing later than the 60s target) must push the
+	// delay down, so that the next submission happens sooner: the correction moves opposite
+	// the observed error, otherwise the loop would never converge.
+	s.Observe(120*time.Second, 60)
+	s.Observe(120*time.Second, 60)
+
+	got = s.ComputeDelay(context.Background(), big.NewInt(1), 0, 50, stateVar)
+	if want := (60 / 4) * time.Second; got != want {
+		t.Errorf("ComputeDelay() with late observations = %s, want %s (clamped to the lower bound)", got, want)
+	}
+}
+
+func TestPIDStrategyComputeDelayPushesUpAfterEarlyObservations(t *testing.T) {
+	s := NewPIDStrategy(1, 1, 10)
+	stateVar := bindings.TaikoDataStateVariables{BlockFee: 100, ProofTimeTarget: 60}
+
+	// A persistent negative error (proofs landing earlier than the 60s target) must push the
+	// delay up, the mirror image of the late-observations case above.
+	s.Observe(30*time.Second, 60)
+	s.Observe(30*time.Second, 60)
+
+	got := s.ComputeDelay(context.Background(), big.NewInt(1), 0, 50, stateVar)
+	if want := 60 * time.Second; got != want {
+		t.Errorf("ComputeDelay() with early observations = %s, want %s", got, want)
+	}
+}
+
+func TestNewProofDelayStrategy(t *testing.T) {
+	if _, err := NewProofDelayStrategy("unknown", 0, 0, 0); err == nil {
+		t.Error("NewProofDelayStrategy(\"unknown\", ...) returned no error, want one")
+	}
+
+	if s, err := NewProofDelayStrategy(DelayStrategyImmediate, 0, 0, 0); err != nil {
+		t.Errorf("NewProofDelayStrategy(%q, ...) returned error: %v", DelayStrategyImmediate, err)
+	} else if _, ok := s.(*ImmediateStrategy); !ok {
+		t.Errorf("NewProofDelayStrategy(%q, ...) = %T, want *ImmediateStrategy", DelayStrategyImmediate, s)
+	}
+
+	if s, err := NewProofDelayStrategy(DelayStrategyPID, 1, 2, 5); err != nil {
+		t.Errorf("NewProofDelayStrategy(%q, ...) returned error: %v", DelayStrategyPID, err)
+	} else if pid, ok := s.(*PIDStrategy); !ok {
+		t.Errorf("NewProofDelayStrategy(%q, ...) = %T, want *PIDStrategy", DelayStrategyPID, s)
+	} else if pid.KP != 1 || pid.KI != 2 || pid.Window != 5 {
+		t.Errorf("NewProofDelayStrategy(%q, ...) = %+v, want KP=1 KI=2 Window=5", DelayStrategyPID, pid)
+	}
+}
@@ -0,0 +1,121 @@
+package submitter
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// Submitter owns the configuration needed to build and send TaikoL1.proveBlock transactions for
+// a single prover process, wiring the --prover.maxGasTipCap / --prover.maxGasFeeCap /
+// --prover.conditionalTxs / --prover.taikoL1ProposedBlocksSlot / --prover.delayStrategy flags
+// through to getProveBlocksTxOpts and sendTxWithBackoff.
+type Submitter struct {
+	cli            *rpc.Client
+	proverAddress  common.Address
+	taikoL1Address common.Address
+	chainID        *big.Int
+	proverPrivKey  *ecdsa.PrivateKey
+
+	maxGasTipCap *big.Int
+	maxGasFeeCap *big.Int
+
+	conditionalTxs *ConditionalTxResolver
+	delayStrategy  ProofDelayStrategy
+
+	retryInterval        time.Duration
+	txReplacementTimeout time.Duration
+}
+
+// NewSubmitter builds a Submitter from the --prover.maxGasTipCap / --prover.maxGasFeeCap /
+// --prover.conditionalTxs / --prover.taikoL1ProposedBlocksSlot / --prover.delayStrategy flags,
+// probing the connected L1 node's eth_sendRawTransactionConditional support once up front, and,
+// when the configured delay strategy is "pid", starting its TaikoL1.BlockProven subscription in
+// the background for the lifetime of ctx.
+func NewSubmitter(
+	ctx context.Context,
+	c *cli.Context,
+	cli *rpc.Client,
+	taikoL1Address common.Address,
+	proverAddress common.Address,
+	chainID *big.Int,
+	proverPrivKey *ecdsa.PrivateKey,
+	proofCooldownWindow uint64,
+	retryInterval time.Duration,
+	txReplacementTimeout time.Duration,
+) (*Submitter, error) {
+	maxGasTipCap, maxGasFeeCap := MaxGasCapsFromContext(c)
+
+	delayStrategy, err := DelayStrategyFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if pidStrategy, ok := delayStrategy.(*PIDStrategy); ok {
+		go func() {
+			if err := WatchPIDStrategyProofTimes(ctx, cli, pidStrategy); err != nil {
+				log.Error("PID proof delay strategy's BlockProven subscription stopped", "error", err)
+			}
+		}()
+	}
+
+	return &Submitter{
+		cli:            cli,
+		proverAddress:  proverAddress,
+		taikoL1Address: taikoL1Address,
+		chainID:        chainID,
+		proverPrivKey:  proverPrivKey,
+		maxGasTipCap:   maxGasTipCap,
+		maxGasFeeCap:   maxGasFeeCap,
+		conditionalTxs: NewConditionalTxResolver(
+			ctx,
+			cli,
+			ConditionalTxModeFromContext(c),
+			taikoL1Address,
+			ProposedBlocksBaseSlotFromContext(c),
+			MetaHashFieldOffsetFromContext(c),
+			proofCooldownWindow,
+		),
+		delayStrategy:        delayStrategy,
+		retryInterval:        retryInterval,
+		txReplacementTimeout: txReplacementTimeout,
+	}, nil
+}
+
+// SubmitProof builds a fee-capped proveBlock transaction for the given block and sends it with
+// sendTxWithBackoff, which bumps and eventually caps its fees as laid out in bumpTxFees.
+func (s *Submitter) SubmitProof(
+	ctx context.Context,
+	blockID *big.Int,
+	proposedAt uint64,
+	expectedReward uint64,
+	meta *bindings.TaikoDataBlockMetadata,
+	sendTxFunc func(opts *bind.TransactOpts) (*types.Transaction, error),
+) error {
+	txOpts, err := getProveBlocksTxOpts(ctx, s.cli.L1, s.chainID, s.proverPrivKey, s.maxGasTipCap, s.maxGasFeeCap)
+	if err != nil {
+		return err
+	}
+
+	return sendTxWithBackoff(ctx, s.cli, blockID, proposedAt, expectedReward, meta, &sendTxWithBackoffOpts{
+		ProverAddress:        s.proverAddress,
+		TaikoL1Address:       s.taikoL1Address,
+		TxOpts:               txOpts,
+		MaxGasTipCap:         s.maxGasTipCap,
+		MaxGasFeeCap:         s.maxGasFeeCap,
+		ConditionalTxOpts:    s.conditionalTxs.ConditionalTxOpts(blockID, meta),
+		DelayStrategy:        s.delayStrategy,
+		RetryInterval:        s.retryInterval,
+		TxReplacementTimeout: s.txReplacementTimeout,
+	}, sendTxFunc)
+}
@@ -3,7 +3,6 @@ package submitter
 import (
 	"context"
 	"crypto/ecdsa"
-	"crypto/rand"
 	"errors"
 	"fmt"
 	"math/big"
@@ -24,6 +23,10 @@ import (
 var (
 	errUnretryable = errors.New("unretryable")
 	errNeedWaiting = errors.New("need waiting before the proof submission")
+	// minGasBumpNumerator / minGasBumpDenominator represent the minimum 12.5% fee bump most
+	// EL clients require to accept a replacement transaction reusing the same nonce.
+	minGasBumpNumerator   = big.NewInt(1125)
+	minGasBumpDenominator = big.NewInt(1000)
 )
 
 // isSubmitProofTxErrorRetryable checks whether the error returned by a proof submission transaction
@@ -37,48 +40,114 @@ func isSubmitProofTxErrorRetryable(err error, blockID *big.Int) bool {
 	return false
 }
 
-// randomGas returns a random gas price by adding 1-600 to `baseGas`.
-func randomGas(baseGas *big.Int) *big.Int {
-	randomMax := big.NewInt(100000000000)
-	randomMin := big.NewInt(1000000000)
-	rangeValue := new(big.Int).Sub(randomMax, randomMin)
-
-	randomNum, _ := rand.Int(rand.Reader, rangeValue)
-
-	return new(big.Int).Add(baseGas, randomNum)
-}
-
 // getProveBlocksTxOpts creates a bind.TransactOpts instance using the given private key.
 // Used for creating TaikoL1.proveBlock and TaikoL1.proveBlockInvalid transactions.
+// maxGasTipCap and maxGasFeeCap (sourced from the --prover.maxGasTipCap / --prover.maxGasFeeCap
+// flags) cap the suggested fees; either may be nil to leave that fee uncapped.
 func getProveBlocksTxOpts(
 	ctx context.Context,
 	cli *ethclient.Client,
 	chainID *big.Int,
 	proverPrivKey *ecdsa.PrivateKey,
+	maxGasTipCap *big.Int,
+	maxGasFeeCap *big.Int,
 ) (*bind.TransactOpts, error) {
 	opts, err := bind.NewKeyedTransactorWithChainID(proverPrivKey, chainID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Don't request the gasPrice from the node
-	// gasTipCap, err := cli.SuggestGasTipCap(ctx)
-	// if err != nil {
-	// 	if rpc.IsMaxPriorityFeePerGasNotFoundError(err) {
-	// 		gasTipCap = rpc.FallbackGasTipCap
-	// 	} else {
-	// 		return nil, err
-	// 	}
-	// }
-
-	// Randomly set the gasPrice to (4000 + random value) gwei
-	opts.GasTipCap = randomGas(big.NewInt(4000000000000))
+	gasTipCap, err := cli.SuggestGasTipCap(ctx)
+	if err != nil {
+		if rpc.IsMaxPriorityFeePerGasNotFoundError(err) {
+			gasTipCap = rpc.FallbackGasTipCap
+		} else {
+			return nil, err
+		}
+	}
+	if maxGasTipCap != nil && gasTipCap.Cmp(maxGasTipCap) > 0 {
+		gasTipCap = maxGasTipCap
+	}
+
+	head, err := cli.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if head.BaseFee == nil {
+		return nil, fmt.Errorf("L1 node is not London-compatible, no baseFee found in the latest header")
+	}
+
+	gasFeeCap := new(big.Int).Add(new(big.Int).Mul(head.BaseFee, common.Big2), gasTipCap)
+	if maxGasFeeCap != nil && gasFeeCap.Cmp(maxGasFeeCap) > 0 {
+		gasFeeCap = maxGasFeeCap
+	}
+
+	opts.GasTipCap = gasTipCap
+	opts.GasFeeCap = gasFeeCap
 	opts.GasLimit = uint64(1500000)
+	// Sign the transaction but let sendTxWithBackoff broadcast it, so it can choose between a
+	// plain send and a conditional send; when it decides to wait rather than bump the fees, it
+	// keeps watching the already broadcast transaction without sending it again.
+	opts.NoSend = true
 
 	return opts, nil
 }
 
+// bumpTxFees bumps the given transaction's tip cap and fee cap by at least the minimum
+// replacement percentage required by most EL clients, clamped by maxGasTipCap / maxGasFeeCap,
+// and reuses the original transaction's nonce so the replacement races out the still-pending
+// original. It reports false, leaving opts untouched, when the bumped fees are clamped back
+// down to the tx's current fees (i.e. the caps have already been reached): resending at an
+// unchanged fee would just be rejected by the EL client as underpriced, so the caller should
+// give up replacing and keep watching tx instead.
+func bumpTxFees(opts *bind.TransactOpts, tx *types.Transaction, maxGasTipCap *big.Int, maxGasFeeCap *big.Int) bool {
+	bumpedTipCap := new(big.Int).Div(new(big.Int).Mul(tx.GasTipCap(), minGasBumpNumerator), minGasBumpDenominator)
+	bumpedFeeCap := new(big.Int).Div(new(big.Int).Mul(tx.GasFeeCap(), minGasBumpNumerator), minGasBumpDenominator)
+
+	if maxGasTipCap != nil && bumpedTipCap.Cmp(maxGasTipCap) > 0 {
+		bumpedTipCap = maxGasTipCap
+	}
+	if maxGasFeeCap != nil && bumpedFeeCap.Cmp(maxGasFeeCap) > 0 {
+		bumpedFeeCap = maxGasFeeCap
+	}
+
+	if bumpedTipCap.Cmp(tx.GasTipCap()) <= 0 && bumpedFeeCap.Cmp(tx.GasFeeCap()) <= 0 {
+		return false
+	}
+
+	opts.Nonce = new(big.Int).SetUint64(tx.Nonce())
+	opts.GasTipCap = bumpedTipCap
+	opts.GasFeeCap = bumpedFeeCap
+	return true
+}
+
+// sendTxWithBackoffOpts bundles sendTxWithBackoff's submission-tuning parameters. Grouping them
+// avoids a long run of same-typed positional parameters (e.g. two adjacent *big.Int fee caps
+// and two adjacent common.Address values) that the compiler can't catch if transposed.
+type sendTxWithBackoffOpts struct {
+	ProverAddress  common.Address
+	TaikoL1Address common.Address
+	TxOpts         *bind.TransactOpts
+	MaxGasTipCap   *big.Int
+	MaxGasFeeCap   *big.Int
+
+	// ConditionalTxOpts, when non-nil, makes sendTxWithBackoff broadcast via
+	// eth_sendRawTransactionConditional instead of a plain send, see ConditionalTxResolver.
+	ConditionalTxOpts *rpc.TransactionConditionalOptions
+	DelayStrategy     ProofDelayStrategy
+
+	RetryInterval        time.Duration
+	TxReplacementTimeout time.Duration
+}
+
 // sendTxWithBackoff tries to send the given proof submission transaction with a backoff policy.
+// Before broadcasting anything, it checks the mempool for an already-pending proveBlock
+// transaction for this blockID (e.g. left over from a previous process restart) and watches
+// that one instead of racing it with a duplicate. If the in-flight transaction (whether
+// discovered or broadcast by this call) has been pending for longer than TxReplacementTimeout,
+// it is replaced with a transaction reusing the same nonce and bumping the tip/fee caps by the
+// minimum replacement percentage, repeating until confirmed or capped by MaxGasTipCap / MaxGasFeeCap.
+// How long to wait after proposedAt before submitting at all is delegated to opts.DelayStrategy.
 func sendTxWithBackoff(
 	ctx context.Context,
 	cli *rpc.Client,
@@ -86,12 +155,14 @@ func sendTxWithBackoff(
 	proposedAt uint64,
 	expectedReward uint64,
 	meta *bindings.TaikoDataBlockMetadata,
-	sendTxFunc func() (*types.Transaction, error),
-	retryInterval time.Duration,
+	opts *sendTxWithBackoffOpts,
+	sendTxFunc func(opts *bind.TransactOpts) (*types.Transaction, error),
 ) error {
 	var (
 		isUnretryableError bool
 		proposedTime       = time.Unix(int64(proposedAt), 0)
+		lastSentTx         *types.Transaction
+		lastSentAt         time.Time
 	)
 
 	if err := backoff.Retry(func() error {
@@ -142,21 +213,7 @@ func sendTxWithBackoff(
 					return err
 				}
 
-				targetDelay := stateVar.ProofTimeTarget * 4
-				if stateVar.BlockFee != 0 {
-					targetDelay = uint64(float64(expectedReward) / float64(stateVar.BlockFee) * float64(stateVar.ProofTimeTarget))
-					if targetDelay < stateVar.ProofTimeTarget/4 {
-						targetDelay = stateVar.ProofTimeTarget / 4
-					} else if targetDelay > stateVar.ProofTimeTarget*4 {
-						targetDelay = stateVar.ProofTimeTarget * 4
-					}
-				}
-
-				// Ignore the targetDelay, submit the proveBlock transaction immediately.
-				if targetDelay > 0 {
-					// Set the targetDelay to negative value if it's positive
-					targetDelay = 0 - targetDelay
-				}
+				targetDelay := opts.DelayStrategy.ComputeDelay(ctx, blockID, proposedAt, expectedReward, stateVar)
 
 				log.Info(
 					"Target delay",
@@ -166,10 +223,10 @@ func sendTxWithBackoff(
 					"blockFee", stateVar.BlockFee,
 					"proofTimeTarget", stateVar.ProofTimeTarget,
 					"proposedTime", proposedTime,
-					"timeToWait", time.Until(proposedTime.Add(time.Duration(targetDelay)*time.Second)),
+					"timeToWait", time.Until(proposedTime.Add(targetDelay)),
 				)
 
-				if time.Now().Before(proposedTime.Add(time.Duration(targetDelay) * time.Second)) {
+				if time.Now().Before(proposedTime.Add(targetDelay)) {
 					return errNeedWaiting
 				}
 			} else {
@@ -178,20 +235,78 @@ func sendTxWithBackoff(
 			}
 		}
 
-		tx, err := sendTxFunc()
-		if err != nil {
-			err = encoding.TryParsingCustomError(err)
-			if isSubmitProofTxErrorRetryable(err, blockID) {
-				log.Info("Retry sending TaikoL1.proveBlock transaction", "blockID", blockID, "reason", err)
+		// If we haven't broadcast anything yet this process, check whether another proveBlock
+		// transaction for this blockID (e.g. from a previous process restart) is already
+		// sitting in the mempool, and watch it instead of racing a nonce-colliding duplicate.
+		if lastSentTx == nil {
+			pendingTx, err := findPendingProveBlockTx(ctx, cli, opts.ProverAddress, opts.TaikoL1Address, blockID)
+			if err != nil {
+				log.Warn("Failed to check the mempool for a pending proveBlock transaction", "blockID", blockID, "error", err)
+			} else if pendingTx != nil {
+				log.Info(
+					"Found a pending TaikoL1.proveBlock transaction in the mempool, watching it",
+					"blockID", blockID,
+					"txHash", pendingTx.Hash(),
+				)
+				lastSentTx, lastSentAt = pendingTx, time.Now()
+			}
+		}
+
+		txToWatch := lastSentTx
+		shouldResend := lastSentTx == nil
+
+		// If the in-flight transaction has been pending for too long, try to replace it: bump
+		// its tip/fee caps by the minimum replacement percentage and reuse its nonce. If the
+		// caps are already reached, give up replacing (a same-fee resend would just be rejected
+		// as underpriced by the EL client) and keep watching the original instead, resetting the
+		// timer so we don't recompute this every RetryInterval.
+		if lastSentTx != nil && time.Since(lastSentAt) > opts.TxReplacementTimeout {
+			if bumpTxFees(opts.TxOpts, lastSentTx, opts.MaxGasTipCap, opts.MaxGasFeeCap) {
+				log.Warn(
+					"Replacing pending TaikoL1.proveBlock transaction",
+					"blockID", blockID,
+					"txHash", lastSentTx.Hash(),
+					"pendingFor", time.Since(lastSentAt),
+				)
+				shouldResend = true
+			} else {
+				log.Warn(
+					"proveBlock transaction fees already at the configured cap, giving up replacing and continuing to watch",
+					"blockID", blockID,
+					"txHash", lastSentTx.Hash(),
+				)
+				lastSentAt = time.Now()
+			}
+		}
+
+		if shouldResend {
+			tx, err := sendTxFunc(opts.TxOpts)
+			if err != nil {
+				err = encoding.TryParsingCustomError(err)
+				if isSubmitProofTxErrorRetryable(err, blockID) {
+					log.Info("Retry sending TaikoL1.proveBlock transaction", "blockID", blockID, "reason", err)
+					return err
+				}
+
+				isUnretryableError = true
+				return nil
+			}
+
+			if err := broadcastTx(ctx, cli, tx, opts.ConditionalTxOpts); err != nil {
+				log.Warn("Failed to broadcast TaikoL1.proveBlock transaction", "blockID", blockID, "txHash", tx.Hash(), "error", err)
 				return err
 			}
 
-			isUnretryableError = true
-			return nil
+			lastSentTx, lastSentAt = tx, time.Now()
+			txToWatch = tx
 		}
 
-		if _, err := rpc.WaitReceipt(ctx, cli.L1, tx); err != nil {
-			log.Warn("Failed to wait till transaction executed", "blockID", blockID, "txHash", tx.Hash(), "error", err)
+		// Bound the wait by TxReplacementTimeout, so that a transaction which is still pending
+		// hands control back to the loop above to be replaced.
+		waitCtx, cancel := context.WithTimeout(ctx, opts.TxReplacementTimeout)
+		defer cancel()
+		if _, err := rpc.WaitReceipt(waitCtx, cli.L1, txToWatch); err != nil {
+			log.Warn("Failed to wait till transaction executed", "blockID", blockID, "txHash", txToWatch.Hash(), "error", err)
 			return err
 		}
 
@@ -202,7 +317,7 @@ func sendTxWithBackoff(
 		)
 
 		return nil
-	}, backoff.NewConstantBackOff(retryInterval)); err != nil {
+	}, backoff.NewConstantBackOff(opts.RetryInterval)); err != nil {
 		return fmt.Errorf("failed to send TaikoL1.proveBlock transaction: %w", err)
 	}
 
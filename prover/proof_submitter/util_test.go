@@ -0,0 +1,70 @@
+package submitter
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func dynamicFeeTx(nonce uint64, gasTipCap, gasFeeCap *big.Int) *types.Transaction {
+	return types.NewTx(&types.DynamicFeeTx{
+		Nonce:     nonce,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       21000,
+	})
+}
+
+func TestBumpTxFeesBumpsByMinimumPercentage(t *testing.T) {
+	tx := dynamicFeeTx(5, big.NewInt(1000), big.NewInt(2000))
+	opts := &bind.TransactOpts{}
+
+	if ok := bumpTxFees(opts, tx, nil, nil); !ok {
+		t.Fatal("bumpTxFees() = false, want true")
+	}
+
+	if opts.Nonce.Uint64() != 5 {
+		t.Errorf("opts.Nonce = %d, want 5", opts.Nonce.Uint64())
+	}
+	if want := big.NewInt(1125); opts.GasTipCap.Cmp(want) != 0 {
+		t.Errorf("opts.GasTipCap = %s, want %s", opts.GasTipCap, want)
+	}
+	if want := big.NewInt(2250); opts.GasFeeCap.Cmp(want) != 0 {
+		t.Errorf("opts.GasFeeCap = %s, want %s", opts.GasFeeCap, want)
+	}
+}
+
+func TestBumpTxFeesClampedToMaxCaps(t *testing.T) {
+	tx := dynamicFeeTx(5, big.NewInt(1000), big.NewInt(2000))
+	opts := &bind.TransactOpts{}
+	maxGasTipCap, maxGasFeeCap := big.NewInt(1050), big.NewInt(2100)
+
+	if ok := bumpTxFees(opts, tx, maxGasTipCap, maxGasFeeCap); !ok {
+		t.Fatal("bumpTxFees() = false, want true")
+	}
+
+	if opts.GasTipCap.Cmp(maxGasTipCap) != 0 {
+		t.Errorf("opts.GasTipCap = %s, want %s (clamped)", opts.GasTipCap, maxGasTipCap)
+	}
+	if opts.GasFeeCap.Cmp(maxGasFeeCap) != 0 {
+		t.Errorf("opts.GasFeeCap = %s, want %s (clamped)", opts.GasFeeCap, maxGasFeeCap)
+	}
+}
+
+func TestBumpTxFeesGivesUpAtCap(t *testing.T) {
+	// The tx's current fees already equal the configured caps, so a bump clamps right back down
+	// to the same values: bumpTxFees must report false instead of signaling a same-fee resend.
+	tx := dynamicFeeTx(5, big.NewInt(1000), big.NewInt(2000))
+	opts := &bind.TransactOpts{GasTipCap: big.NewInt(999), GasFeeCap: big.NewInt(1999)}
+	maxGasTipCap, maxGasFeeCap := big.NewInt(1000), big.NewInt(2000)
+
+	if ok := bumpTxFees(opts, tx, maxGasTipCap, maxGasFeeCap); ok {
+		t.Fatal("bumpTxFees() = true, want false")
+	}
+
+	if opts.GasTipCap.Cmp(big.NewInt(999)) != 0 || opts.GasFeeCap.Cmp(big.NewInt(1999)) != 0 {
+		t.Errorf("bumpTxFees() mutated opts on failure: %+v", opts)
+	}
+}